@@ -0,0 +1,21 @@
+#!/bin/bash
+set -eo pipefail
+
+# Vendors the dependencies of the bundled influxdb query package and runs its
+# build and test gates. The package needs github.com/influxdata/influxql plus
+# uber-go/zap and xlab/treeprint, which glide.yaml pins at the revisions from
+# the vendored influxdb's Godeps; run this from a machine with network access
+# and commit the resulting vendor/ additions.
+
+cd "$(dirname "$0")/.."
+
+if ! command -v glide > /dev/null; then
+    echo "must have glide on the PATH (https://github.com/Masterminds/glide)"
+    exit 1
+fi
+
+glide install
+
+go build ./vendor/github.com/influxdata/influxdb/query/
+go vet ./vendor/github.com/influxdata/influxdb/query/
+go test ./vendor/github.com/influxdata/influxdb/query/